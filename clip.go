@@ -0,0 +1,257 @@
+package geometry
+
+import "github.com/paulmach/orb"
+
+// clipGeometryToBound intersects geom (a Polygon or MultiPolygon) with an
+// axis-aligned bound using Sutherland-Hodgman clipping against the bound's
+// four edges. orb doesn't provide polygon clipping itself, so Divide and its
+// variants rely on this to keep recursive tiles confined to the source
+// geometry's actual shape instead of its bounding box.
+func clipGeometryToBound(geom orb.Geometry, bound orb.Bound) (orb.Geometry, bool) {
+	switch g := geom.(type) {
+	case orb.Polygon:
+		return clipPolygonToBound(g, bound)
+	case orb.MultiPolygon:
+		var result orb.MultiPolygon
+
+		for _, poly := range g {
+			if clipped, ok := clipPolygonToBound(poly, bound); ok {
+				result = append(result, clipped)
+			}
+		}
+
+		if len(result) == 0 {
+			return nil, false
+		}
+
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+// clipPolygonToBound clips a polygon's outer ring and holes to bound,
+// dropping holes that are clipped away entirely. It reports false if the
+// outer ring doesn't survive clipping.
+func clipPolygonToBound(poly orb.Polygon, bound orb.Bound) (orb.Polygon, bool) {
+	var clipped orb.Polygon
+
+	for i, ring := range poly {
+		clippedRing := clipRingToBound(ring, bound)
+
+		if len(clippedRing) == 0 {
+			if i == 0 {
+				return nil, false
+			}
+
+			continue
+		}
+
+		clipped = append(clipped, clippedRing)
+	}
+
+	if len(clipped) == 0 {
+		return nil, false
+	}
+
+	return clipped, true
+}
+
+// clipRingToBound clips a single ring against bound's four half-planes in
+// turn (Sutherland-Hodgman), returning nil if nothing of the ring survives.
+func clipRingToBound(ring orb.Ring, bound orb.Bound) orb.Ring {
+	if len(ring) == 0 {
+		return nil
+	}
+
+	points := []orb.Point(ring)
+
+	points = clipEdge(points, func(p orb.Point) bool { return p[0] >= bound.Min[0] },
+		func(a, b orb.Point) orb.Point { return lerpX(a, b, bound.Min[0]) })
+	points = clipEdge(points, func(p orb.Point) bool { return p[0] <= bound.Max[0] },
+		func(a, b orb.Point) orb.Point { return lerpX(a, b, bound.Max[0]) })
+	points = clipEdge(points, func(p orb.Point) bool { return p[1] >= bound.Min[1] },
+		func(a, b orb.Point) orb.Point { return lerpY(a, b, bound.Min[1]) })
+	points = clipEdge(points, func(p orb.Point) bool { return p[1] <= bound.Max[1] },
+		func(a, b orb.Point) orb.Point { return lerpY(a, b, bound.Max[1]) })
+
+	if len(points) < 3 {
+		return nil
+	}
+
+	if points[0] != points[len(points)-1] {
+		points = append(points, points[0])
+	}
+
+	return orb.Ring(points)
+}
+
+// clipEdge runs one pass of Sutherland-Hodgman, keeping points on the inside
+// of a single half-plane and inserting the edge/boundary intersection
+// wherever a segment crosses it.
+func clipEdge(points []orb.Point, inside func(orb.Point) bool, intersect func(a, b orb.Point) orb.Point) []orb.Point {
+	if len(points) == 0 {
+		return nil
+	}
+
+	var out []orb.Point
+	prev := points[len(points)-1]
+	prevIn := inside(prev)
+
+	for _, curr := range points {
+		currIn := inside(curr)
+
+		switch {
+		case currIn && !prevIn:
+			out = append(out, intersect(prev, curr), curr)
+		case currIn:
+			out = append(out, curr)
+		case prevIn:
+			out = append(out, intersect(prev, curr))
+		}
+
+		prev, prevIn = curr, currIn
+	}
+
+	return out
+}
+
+func lerpX(a, b orb.Point, x float64) orb.Point {
+	t := (x - a[0]) / (b[0] - a[0])
+
+	return orb.Point{x, a[1] + t*(b[1]-a[1])}
+}
+
+func lerpY(a, b orb.Point, y float64) orb.Point {
+	t := (y - a[1]) / (b[1] - a[1])
+
+	return orb.Point{a[0] + t*(b[0]-a[0]), y}
+}
+
+// clipGeometryToRing intersects geom (a Polygon or MultiPolygon) with the
+// region enclosed by clip, running one Sutherland-Hodgman pass per edge of
+// clip instead of just its bound's four half-planes. This is exact when
+// clip is convex. For a concave clip ring, geom is cut by the line through
+// each edge of clip, keeping the side the average of clip's vertices falls
+// on, so parts of geom inside clip can be dropped and parts outside it kept.
+// Holes in clip are not subtracted back out.
+func clipGeometryToRing(geom orb.Geometry, clip orb.Ring) (orb.Geometry, bool) {
+	switch g := geom.(type) {
+	case orb.Polygon:
+		return clipPolygonToRing(g, clip)
+	case orb.MultiPolygon:
+		var result orb.MultiPolygon
+
+		for _, poly := range g {
+			if clipped, ok := clipPolygonToRing(poly, clip); ok {
+				result = append(result, clipped)
+			}
+		}
+
+		if len(result) == 0 {
+			return nil, false
+		}
+
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+// clipPolygonToRing clips a polygon's outer ring and holes against clip,
+// dropping holes that are clipped away entirely. It reports false if the
+// outer ring doesn't survive clipping.
+func clipPolygonToRing(poly orb.Polygon, clip orb.Ring) (orb.Polygon, bool) {
+	var clipped orb.Polygon
+
+	for i, ring := range poly {
+		clippedRing := clipRingToRing(ring, clip)
+
+		if len(clippedRing) == 0 {
+			if i == 0 {
+				return nil, false
+			}
+
+			continue
+		}
+
+		clipped = append(clipped, clippedRing)
+	}
+
+	if len(clipped) == 0 {
+		return nil, false
+	}
+
+	return clipped, true
+}
+
+// clipRingToRing clips ring against each edge of clip in turn
+// (Sutherland-Hodgman). "Inside" an edge is whichever side clip's own
+// interior point falls on, so the winding direction of clip doesn't matter.
+func clipRingToRing(ring orb.Ring, clip orb.Ring) orb.Ring {
+	n := len(clip) - 1 // clip's ring repeats its first point as its last
+
+	if len(ring) == 0 || n < 3 {
+		return nil
+	}
+
+	points := []orb.Point(ring)
+	interior := ringInteriorPoint(clip)
+
+	for i := 0; i < n; i++ {
+		a, b := clip[i], clip[i+1]
+		side := orientation(a, b, interior)
+
+		points = clipEdge(points, func(p orb.Point) bool { return orientation(a, b, p) == side },
+			func(p1, p2 orb.Point) orb.Point { return lineIntersect(p1, p2, a, b) })
+
+		if len(points) == 0 {
+			return nil
+		}
+	}
+
+	if len(points) < 3 {
+		return nil
+	}
+
+	if points[0] != points[len(points)-1] {
+		points = append(points, points[0])
+	}
+
+	return orb.Ring(points)
+}
+
+// ringInteriorPoint returns the average of ring's vertices, which falls
+// strictly inside any convex ring and is used as the reference point
+// clipRingToRing tests each edge's "inside" half against.
+func ringInteriorPoint(ring orb.Ring) orb.Point {
+	n := len(ring) - 1
+	if n <= 0 {
+		return ring.Bound().Center()
+	}
+
+	var x, y float64
+	for _, pt := range ring[:n] {
+		x += pt[0]
+		y += pt[1]
+	}
+
+	return orb.Point{x / float64(n), y / float64(n)}
+}
+
+// lineIntersect returns the point where segment p1-p2 crosses the infinite
+// line through a-b. clipEdge only calls this when exactly one of p1, p2 is
+// on the inside half, so the segment is guaranteed to actually cross.
+func lineIntersect(p1, p2, a, b orb.Point) orb.Point {
+	x1, y1, x2, y2 := p1[0], p1[1], p2[0], p2[1]
+	x3, y3, x4, y4 := a[0], a[1], b[0], b[1]
+
+	denom := (x1-x2)*(y3-y4) - (y1-y2)*(x3-x4)
+	if denom == 0 {
+		return p1
+	}
+
+	t := ((x1-x3)*(y3-y4) - (y1-y3)*(x3-x4)) / denom
+
+	return orb.Point{x1 + t*(x2-x1), y1 + t*(y2-y1)}
+}
@@ -0,0 +1,153 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestClipPolygonToBoundNoOverlap(t *testing.T) {
+	triangle := orb.Polygon{orb.Ring{{0, 0}, {3, 0}, {0, 3}, {0, 0}}}
+	bound := orb.Bound{Min: orb.Point{5, 5}, Max: orb.Point{6, 6}}
+
+	clipped, ok := clipPolygonToBound(triangle, bound)
+	if ok {
+		t.Fatalf("clipPolygonToBound() = %v, true; want no overlap with a disjoint bound", clipped)
+	}
+}
+
+func TestClipPolygonToBoundFollowsHypotenuse(t *testing.T) {
+	triangle := orb.Polygon{orb.Ring{{0, 0}, {3, 0}, {0, 3}, {0, 0}}}
+	bound := orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}}
+
+	clipped, ok := clipPolygonToBound(triangle, bound)
+	if !ok {
+		t.Fatal("clipPolygonToBound() reported no overlap, want a surviving tile")
+	}
+
+	poly, err := NewPolygonFromOrb(clipped)
+	if err != nil {
+		t.Fatalf("NewPolygonFromOrb: %v", err)
+	}
+
+	// Only the sliver of the bound's (1,1)-(3,3) square below the
+	// hypotenuse x+y<=3 overlaps the triangle: the corner triangle
+	// (1,1),(2,1),(1,2), area 0.5 — not the full 2x2 bound (area 4).
+	const wantArea = 0.5
+	if diff := poly.Area() - wantArea; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("Area() = %v, want %v", poly.Area(), wantArea)
+	}
+}
+
+func TestClipPolygonToBoundPartialOverlap(t *testing.T) {
+	triangle := orb.Polygon{orb.Ring{{0, 0}, {3, 0}, {0, 3}, {0, 0}}}
+	bound := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}}
+
+	clipped, ok := clipPolygonToBound(triangle, bound)
+	if !ok {
+		t.Fatal("clipPolygonToBound() reported no overlap, want a surviving tile")
+	}
+
+	poly, err := NewPolygonFromOrb(clipped)
+	if err != nil {
+		t.Fatalf("NewPolygonFromOrb: %v", err)
+	}
+
+	const wantArea = 1
+	if diff := poly.Area() - wantArea; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("Area() = %v, want %v", poly.Area(), wantArea)
+	}
+}
+
+func TestClipGeometryToBoundDropsHolesClippedAway(t *testing.T) {
+	withHole := orb.Polygon{
+		orb.Ring{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}},
+		orb.Ring{{8, 8}, {8, 9}, {9, 9}, {9, 8}, {8, 8}},
+	}
+	bound := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{5, 5}}
+
+	clipped, ok := clipGeometryToBound(withHole, bound)
+	if !ok {
+		t.Fatal("clipGeometryToBound() reported no overlap")
+	}
+
+	poly := clipped.(orb.Polygon)
+	if len(poly) != 1 {
+		t.Fatalf("len(clipped) = %d, want 1 (hole outside bound should be dropped)", len(poly))
+	}
+}
+
+func TestClipGeometryToRingFollowsNonRectangularShape(t *testing.T) {
+	square := orb.Polygon{orb.Ring{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}}}
+	diamond := orb.Ring{{2, 0}, {4, 2}, {2, 4}, {0, 2}, {2, 0}}
+
+	clipped, ok := clipGeometryToRing(square, diamond)
+	if !ok {
+		t.Fatal("clipGeometryToRing() reported no overlap")
+	}
+
+	poly, err := NewPolygonFromOrb(clipped)
+	if err != nil {
+		t.Fatalf("NewPolygonFromOrb: %v", err)
+	}
+
+	// The diamond's own area is 8 (half its 4x4 bbox of 16). Clipping the
+	// full square to the diamond's ring should follow that shape exactly.
+	const wantArea = 8
+	if diff := poly.Area() - wantArea; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("Area() = %v, want %v", poly.Area(), wantArea)
+	}
+}
+
+func TestClipGeometryToRingNoOverlap(t *testing.T) {
+	triangle := orb.Polygon{orb.Ring{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}
+	farAway := orb.Ring{{10, 10}, {11, 10}, {11, 11}, {10, 11}, {10, 10}}
+
+	if _, ok := clipGeometryToRing(triangle, farAway); ok {
+		t.Fatal("clipGeometryToRing() = ok, want no overlap with a disjoint ring")
+	}
+}
+
+func TestBisectDepthRoundsToNearestPowerOfTwo(t *testing.T) {
+	cases := []struct {
+		n    int
+		want int
+	}{
+		{1, 0},
+		{2, 1},
+		{3, 2},
+		{4, 2},
+		{5, 2},
+		{6, 3},
+		{8, 3},
+		{9, 3},
+		{16, 4},
+	}
+
+	for _, tc := range cases {
+		if got := bisectDepth(tc.n); got != tc.want {
+			t.Errorf("bisectDepth(%d) = %d, want %d (%d pieces)", tc.n, got, tc.want, 1<<tc.want)
+		}
+	}
+}
+
+func TestDivideByCountClipsToSourceShape(t *testing.T) {
+	triangle := mustPolygon(t, "POLYGON((0 0,4 0,0 4,0 0))")
+
+	tiles := triangle.DivideByCount(4)
+
+	var area float64
+	for _, tile := range tiles {
+		area += tile.Area()
+	}
+
+	if diff := area - triangle.Area(); diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("sum of tile areas = %v, want %v (source area)", area, triangle.Area())
+	}
+
+	for _, tile := range tiles {
+		if tile.Area() >= triangle.Area() {
+			t.Errorf("tile area %v not smaller than source area %v", tile.Area(), triangle.Area())
+		}
+	}
+}
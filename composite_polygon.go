@@ -0,0 +1,236 @@
+package geometry
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkt"
+	"github.com/paulmach/orb/geojson"
+)
+
+// compositePolygon represents a GeometryCollection that contains one or more
+// polygonal members. Non-polygonal members (points, lines, ...) are dropped
+// when the collection is decoded, but the original collection is kept around
+// so callers can still reach them through Raw.
+type compositePolygon struct {
+	centroid Point
+	area     float64
+	srid     Projection
+	bound    orb.Bound
+	parts    []Polygon
+	raw      orb.Collection
+}
+
+// newCompositePolygon builds a Polygon out of the polygonal members of a
+// decoded GeometryCollection, preserving the original collection in Raw.
+// It returns ErrFailedGeometryType if the collection contains no
+// Polygon/MultiPolygon members.
+func newCompositePolygon(collection orb.Collection) (Polygon, error) {
+	var parts []Polygon
+
+	for _, member := range collection {
+		if !isPolygon(member) {
+			continue
+		}
+
+		part, err := NewPolygonFromOrb(member)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part.Iter()...)
+	}
+
+	if len(parts) == 0 {
+		return nil, ErrFailedGeometryType
+	}
+
+	bound := parts[0].Bound()
+	var area float64
+	var cx, cy float64
+
+	for _, part := range parts {
+		bound = bound.Union(part.Bound())
+		area += part.Area()
+		cx += part.Centroid().Lon() * part.Area()
+		cy += part.Centroid().Lat() * part.Area()
+	}
+
+	var centroid Point
+	if area > 0 {
+		centroid = point{Point: orb.Point{cx / area, cy / area}}
+	} else {
+		centroid = point{Point: bound.Center()}
+	}
+
+	return compositePolygon{
+		centroid: centroid,
+		area:     area,
+		srid:     EPSG4326,
+		bound:    bound,
+		parts:    parts,
+		raw:      collection,
+	}, nil
+}
+
+func (p compositePolygon) GeoJSONType() string {
+	return "GeometryCollection"
+}
+
+func (p compositePolygon) Dimensions() int {
+	return 2
+}
+
+func (p compositePolygon) Bound() orb.Bound {
+	return p.bound
+}
+
+func (p compositePolygon) ToWKT() string {
+	return wkt.MarshalString(p.raw)
+}
+
+func (p compositePolygon) ToGeoJSON() geojson.Geometry {
+	geometries := make([]*geojson.Geometry, len(p.raw))
+	for i, member := range p.raw {
+		geometries[i] = geojson.NewGeometry(member)
+	}
+
+	return geojson.Geometry{
+		Type:       p.GeoJSONType(),
+		Geometries: geometries,
+	}
+}
+
+func (p compositePolygon) Centroid() Point {
+	return p.centroid
+}
+
+func (p compositePolygon) Area() float64 {
+	return p.area
+}
+
+// Divide divides each polygonal member separately and concatenates the
+// results, since the members of a GeometryCollection need not be contiguous.
+func (p compositePolygon) Divide(n float64) []Polygon {
+	var polygons []Polygon
+
+	for _, part := range p.parts {
+		polygons = append(polygons, part.Divide(n)...)
+	}
+
+	return polygons
+}
+
+// DivideByCount divides each polygonal member separately, giving each one a
+// share of n proportional to its own area, and concatenates the results.
+func (p compositePolygon) DivideByCount(n int) []Polygon {
+	var polygons []Polygon
+
+	for _, part := range p.parts {
+		share := n
+		if p.area > 0 {
+			share = int(float64(n) * (part.Area() / p.area))
+		}
+
+		if share < 1 {
+			share = 1
+		}
+
+		polygons = append(polygons, part.DivideByCount(share)...)
+	}
+
+	return polygons
+}
+
+// DivideWithPredicate divides each polygonal member separately and
+// concatenates the results.
+func (p compositePolygon) DivideWithPredicate(stop func(Polygon) bool) []Polygon {
+	var polygons []Polygon
+
+	for _, part := range p.parts {
+		polygons = append(polygons, part.DivideWithPredicate(stop)...)
+	}
+
+	return polygons
+}
+
+// Iter returns the collection's polygonal members.
+func (p compositePolygon) Iter() []Polygon {
+	return p.parts
+}
+
+// Contains reports whether pt lies within any of the collection's
+// polygonal members.
+func (p compositePolygon) Contains(pt Point) bool {
+	for _, part := range p.parts {
+		if part.Contains(pt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Intersects reports whether p shares any area with other.
+func (p compositePolygon) Intersects(other Polygon) bool {
+	return polygonsIntersect(p, other)
+}
+
+// DistanceTo returns the distance, in meters, from pt to the nearest
+// polygonal member, or 0 if pt is inside one of them.
+func (p compositePolygon) DistanceTo(pt Point) float64 {
+	best := math.Inf(1)
+
+	for _, part := range p.parts {
+		if d := part.DistanceTo(pt); d < best {
+			best = d
+		}
+	}
+
+	return best
+}
+
+// Nearest returns whichever of points is closest to p, along with that
+// distance in meters. Nearest panics if points is empty.
+func (p compositePolygon) Nearest(points []Point) (Point, float64) {
+	return nearestPoint(p, points)
+}
+
+// Project returns a copy of p with every member, and the raw collection,
+// projected to target.
+func (p compositePolygon) Project(target Projection) Polygon {
+	parts := make([]Polygon, len(p.parts))
+	bound := p.bound
+
+	for i, part := range p.parts {
+		projected := part.Project(target)
+		parts[i] = projected
+
+		if i == 0 {
+			bound = projected.Bound()
+		} else {
+			bound = bound.Union(projected.Bound())
+		}
+	}
+
+	raw := make(orb.Collection, len(p.raw))
+	for i, member := range p.raw {
+		raw[i] = projectGeometry(member, p.srid, target)
+	}
+
+	return compositePolygon{
+		centroid: p.centroid,
+		area:     p.area,
+		srid:     target,
+		bound:    bound,
+		parts:    parts,
+		raw:      raw,
+	}
+}
+
+// Raw returns the original, unfiltered GeometryCollection members, including
+// any non-polygonal geometries that Iter drops.
+func (p compositePolygon) Raw() orb.Collection {
+	return p.raw
+}
@@ -0,0 +1,69 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func square(minX, minY, maxX, maxY float64) orb.Polygon {
+	return orb.Polygon{orb.Ring{
+		{minX, minY}, {minX, maxY}, {maxX, maxY}, {maxX, minY}, {minX, minY},
+	}}
+}
+
+func TestNewCompositePolygonDropsNonPolygonalMembers(t *testing.T) {
+	collection := orb.Collection{
+		orb.Point{5, 5},
+		square(0, 0, 1, 1),
+		square(10, 10, 11, 11),
+	}
+
+	p, err := newCompositePolygon(collection)
+	if err != nil {
+		t.Fatalf("newCompositePolygon: %v", err)
+	}
+
+	if got := len(p.Iter()); got != 2 {
+		t.Fatalf("len(Iter()) = %d, want 2 (point member dropped)", got)
+	}
+
+	if got := len(p.(compositePolygon).Raw()); got != 3 {
+		t.Fatalf("len(Raw()) = %d, want 3 (point member kept)", got)
+	}
+}
+
+func TestCompositePolygonAreaAndContainsAreAggregated(t *testing.T) {
+	collection := orb.Collection{
+		square(0, 0, 1, 1),
+		square(10, 10, 11, 11),
+	}
+
+	p, err := newCompositePolygon(collection)
+	if err != nil {
+		t.Fatalf("newCompositePolygon: %v", err)
+	}
+
+	const wantArea = 2
+	if diff := p.Area() - wantArea; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("Area() = %v, want %v", p.Area(), wantArea)
+	}
+
+	if got := p.Contains(point{Point: orb.Point{0.5, 0.5}}); !got {
+		t.Errorf("Contains(0.5,0.5) = false, want true")
+	}
+
+	if got := p.Contains(point{Point: orb.Point{5, 5}}); got {
+		t.Errorf("Contains(5,5) = true, want false")
+	}
+}
+
+func TestNewCompositePolygonNoPolygonalMembersErrors(t *testing.T) {
+	collection := orb.Collection{orb.Point{0, 0}, orb.LineString{{0, 0}, {1, 1}}}
+
+	_, err := newCompositePolygon(collection)
+
+	if err != ErrFailedGeometryType {
+		t.Fatalf("err = %v, want %v", err, ErrFailedGeometryType)
+	}
+}
@@ -0,0 +1,24 @@
+package geometry
+
+import "testing"
+
+func TestDivideUsesRealAreaNotBound(t *testing.T) {
+	// A thin diagonal sliver: its bound spans roughly 1100km x 1100km, but
+	// its actual clipped area is tiny. A bbox-based stop predicate would
+	// keep recursing across that whole bound; a real-area predicate should
+	// stop almost immediately since the sliver itself is already under the
+	// threshold.
+	sliver := mustPolygon(t, "POLYGON((0 0,0.0001 0,10 10.0001,10 10,0 0))")
+
+	tiles := sliver.Divide(10_000)
+
+	if len(tiles) > 4 {
+		t.Fatalf("len(tiles) = %d, want a small number for a sliver under the threshold", len(tiles))
+	}
+
+	for _, tile := range tiles {
+		if area := tile.Area(); area > sliver.Area() {
+			t.Errorf("tile area %v exceeds source sliver area %v", area, sliver.Area())
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package geometry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+// ErrInvalidCoordinates is returned when a decoded geometry contains
+// coordinates outside the EPSG:4326 (WGS84 longitude/latitude) range.
+var ErrInvalidCoordinates = errors.New("coordinates out of EPSG:4326 range")
+
+// Feature pairs a Polygon with the string-keyed properties carried by its
+// GeoJSON feature, if any.
+type Feature struct {
+	Polygon
+	Properties map[string]string
+}
+
+type geojsonTypeSniff struct {
+	Type string `json:"type"`
+}
+
+// NewPolygonsFromGeoJSONFile reads and parses the GeoJSON document at path.
+// See NewPolygonsFromGeoJSONReader for the accepted document shapes.
+func NewPolygonsFromGeoJSONFile(path string) ([]Feature, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return NewPolygonsFromGeoJSONReader(f)
+}
+
+// NewPolygonsFromGeoJSONReader parses a GeoJSON document of type Feature,
+// FeatureCollection, Polygon, or MultiPolygon into a flat list of Features.
+// MultiPolygons, whether given directly or nested inside a Feature, are
+// exploded into one Feature per constituent Polygon, each carrying a copy of
+// the source feature's properties. All coordinates are validated to be in
+// EPSG:4326 (longitude in [-180,180], latitude in [-90,90]); otherwise
+// ErrInvalidCoordinates is returned.
+func NewPolygonsFromGeoJSONReader(r io.Reader) ([]Feature, error) {
+	data, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var sniff geojsonTypeSniff
+	if err := json.Unmarshal(data, &sniff); err != nil {
+		return nil, err
+	}
+
+	switch sniff.Type {
+	case "FeatureCollection":
+		fc, err := geojson.UnmarshalFeatureCollection(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var features []Feature
+		for _, f := range fc.Features {
+			fs, err := featuresFromGeoJSON(f.Geometry, f.Properties)
+
+			if err != nil {
+				return nil, err
+			}
+
+			features = append(features, fs...)
+		}
+
+		return features, nil
+	case "Feature":
+		f, err := geojson.UnmarshalFeature(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return featuresFromGeoJSON(f.Geometry, f.Properties)
+	case "Polygon", "MultiPolygon":
+		g, err := geojson.UnmarshalGeometry(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return featuresFromGeoJSON(g.Geometry(), nil)
+	default:
+		return nil, ErrFailedGeometryType
+	}
+}
+
+func featuresFromGeoJSON(geom orb.Geometry, properties geojson.Properties) ([]Feature, error) {
+	if !isWGS84(geom.Bound()) {
+		return nil, ErrInvalidCoordinates
+	}
+
+	props := stringifyProperties(properties)
+
+	switch g := geom.(type) {
+	case orb.MultiPolygon:
+		features := make([]Feature, 0, len(g))
+
+		for _, part := range g {
+			poly, err := NewPolygonFromOrb(part)
+
+			if err != nil {
+				return nil, err
+			}
+
+			features = append(features, Feature{Polygon: poly, Properties: stringifyProperties(properties)})
+		}
+
+		return features, nil
+	case orb.Polygon:
+		poly, err := NewPolygonFromOrb(g)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []Feature{{Polygon: poly, Properties: props}}, nil
+	default:
+		return nil, ErrFailedGeometryType
+	}
+}
+
+func stringifyProperties(properties geojson.Properties) map[string]string {
+	props := make(map[string]string, len(properties))
+
+	for k, v := range properties {
+		props[k] = fmt.Sprintf("%v", v)
+	}
+
+	return props
+}
+
+func isWGS84(bound orb.Bound) bool {
+	return bound.Min[0] >= -180 && bound.Max[0] <= 180 &&
+		bound.Min[1] >= -90 && bound.Max[1] <= 90
+}
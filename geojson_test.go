@@ -0,0 +1,89 @@
+package geometry
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewPolygonsFromGeoJSONReader(t *testing.T) {
+	cases := []struct {
+		name      string
+		body      string
+		wantCount int
+		wantErr   error
+	}{
+		{
+			name:      "bare polygon",
+			body:      `{"type":"Polygon","coordinates":[[[0,0],[0,1],[1,1],[1,0],[0,0]]]}`,
+			wantCount: 1,
+		},
+		{
+			name:      "multipolygon explodes into one feature per part",
+			body:      `{"type":"MultiPolygon","coordinates":[[[[0,0],[0,1],[1,1],[1,0],[0,0]]],[[[10,10],[10,11],[11,11],[11,10],[10,10]]]]}`,
+			wantCount: 2,
+		},
+		{
+			name:      "feature carries its properties",
+			body:      `{"type":"Feature","properties":{"name":"a"},"geometry":{"type":"Polygon","coordinates":[[[0,0],[0,1],[1,1],[1,0],[0,0]]]}}`,
+			wantCount: 1,
+		},
+		{
+			name:      "feature collection",
+			body:      `{"type":"FeatureCollection","features":[{"type":"Feature","properties":{},"geometry":{"type":"Polygon","coordinates":[[[0,0],[0,1],[1,1],[1,0],[0,0]]]}}]}`,
+			wantCount: 1,
+		},
+		{
+			name:    "coordinates out of WGS84 range",
+			body:    `{"type":"Polygon","coordinates":[[[0,0],[0,1000],[1,1000],[1,0],[0,0]]]}`,
+			wantErr: ErrInvalidCoordinates,
+		},
+		{
+			name:    "unsupported geometry type",
+			body:    `{"type":"Point","coordinates":[0,0]}`,
+			wantErr: ErrFailedGeometryType,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			features, err := NewPolygonsFromGeoJSONReader(strings.NewReader(tc.body))
+
+			if tc.wantErr != nil {
+				if err != tc.wantErr {
+					t.Fatalf("err = %v, want %v", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(features) != tc.wantCount {
+				t.Fatalf("len(features) = %d, want %d", len(features), tc.wantCount)
+			}
+		})
+	}
+}
+
+func TestFeaturePropertiesAreStringified(t *testing.T) {
+	body := `{"type":"Feature","properties":{"count":3,"label":"x"},"geometry":{"type":"Polygon","coordinates":[[[0,0],[0,1],[1,1],[1,0],[0,0]]]}}`
+
+	features, err := NewPolygonsFromGeoJSONReader(strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(features) != 1 {
+		t.Fatalf("len(features) = %d, want 1", len(features))
+	}
+
+	if got := features[0].Properties["label"]; got != "x" {
+		t.Errorf("Properties[label] = %q, want %q", got, "x")
+	}
+
+	if got := features[0].Properties["count"]; got != "3" {
+		t.Errorf("Properties[count] = %q, want %q", got, "3")
+	}
+}
@@ -0,0 +1,44 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestPolygonIterExplodesMultiPolygon(t *testing.T) {
+	p := mustPolygon(t, "MULTIPOLYGON(((0 0,0 1,1 1,1 0,0 0)),((10 10,10 11,11 11,11 10,10 10)))")
+
+	parts := p.Iter()
+	if len(parts) != 2 {
+		t.Fatalf("len(Iter()) = %d, want 2", len(parts))
+	}
+
+	if parts[0].Bound().Max[0] >= 10 || parts[1].Bound().Min[0] < 10 {
+		t.Errorf("Iter() parts not split correctly: %+v, %+v", parts[0].Bound(), parts[1].Bound())
+	}
+}
+
+func TestPolygonIterPlainPolygon(t *testing.T) {
+	p := mustPolygon(t, "POLYGON((0 0,0 1,1 1,1 0,0 0))")
+
+	parts := p.Iter()
+	if len(parts) != 1 {
+		t.Fatalf("len(Iter()) = %d, want 1", len(parts))
+	}
+}
+
+func TestNewCompositePolygonExplodesMultiPolygonMember(t *testing.T) {
+	collection := orb.Collection{
+		orb.MultiPolygon{square(0, 0, 1, 1), square(10, 10, 11, 11)},
+	}
+
+	p, err := newCompositePolygon(collection)
+	if err != nil {
+		t.Fatalf("newCompositePolygon: %v", err)
+	}
+
+	if got := len(p.Iter()); got != 2 {
+		t.Fatalf("len(Iter()) = %d, want 2 (MultiPolygon member should explode into its parts)", got)
+	}
+}
@@ -0,0 +1,207 @@
+package geometry
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// limiterLeafSize bounds how many polygons a Limiter index leaf holds before
+// it is split again, trading index depth for per-leaf scan cost.
+const limiterLeafSize = 4
+
+// Limiter clips and tests features against a fixed set of limit polygons
+// (e.g. country or region boundaries); Contains widens each one by
+// bufferMeters, Clip does not (see Clip). It indexes
+// the limit polygons in a binary bound tree built the same way Divide
+// subdivides a bbox, so Clip and Contains only need to descend the branches
+// whose bound actually overlaps the query instead of scanning every limit
+// polygon.
+type Limiter struct {
+	bufferMeters float64
+	root         *limiterNode
+}
+
+type limiterNode struct {
+	bound orb.Bound
+	polys []Polygon
+	left  *limiterNode
+	right *limiterNode
+}
+
+// NewLimiter builds a Limiter from polys, expanding each one's bound by
+// bufferMeters (converted to degrees at that polygon's latitude) before
+// indexing.
+func NewLimiter(polys []Polygon, bufferMeters float64) *Limiter {
+	buffered := make([]Polygon, len(polys))
+	copy(buffered, polys)
+
+	return &Limiter{
+		bufferMeters: bufferMeters,
+		root:         buildLimiterNode(buffered, bufferMeters),
+	}
+}
+
+func buildLimiterNode(polys []Polygon, bufferMeters float64) *limiterNode {
+	if len(polys) == 0 {
+		return nil
+	}
+
+	bound := bufferedBound(polys[0], bufferMeters)
+	for _, p := range polys[1:] {
+		bound = bound.Union(bufferedBound(p, bufferMeters))
+	}
+
+	node := &limiterNode{bound: bound}
+
+	if len(polys) <= limiterLeafSize {
+		node.polys = polys
+
+		return node
+	}
+
+	halves := splitBound(bound)
+	var left, right []Polygon
+
+	for _, p := range polys {
+		pb := bufferedBound(p, bufferMeters)
+		center := pb.Center()
+
+		if halves[0].Contains(center) {
+			left = append(left, p)
+		} else {
+			right = append(right, p)
+		}
+	}
+
+	// Degenerate split (every polygon landed on one side): stop recursing
+	// rather than looping forever on the same partition.
+	if len(left) == 0 || len(right) == 0 {
+		node.polys = polys
+
+		return node
+	}
+
+	node.left = buildLimiterNode(left, bufferMeters)
+	node.right = buildLimiterNode(right, bufferMeters)
+
+	return node
+}
+
+// geometryer exposes the orb.Geometry a plain polygon wraps, mirroring
+// ringer: Clip needs to run real Sutherland-Hodgman clipping against each
+// part's exact shape, and Iter always flattens composites down to parts of
+// concrete type polygon.
+type geometryer interface {
+	geometry() orb.Geometry
+}
+
+func (p polygon) geometry() orb.Geometry {
+	return p.Geometry
+}
+
+// Clip returns the portions of p that fall within the Limiter's region: each
+// part of p is clipped to every overlapping limit polygon's actual outer
+// ring using clipGeometryToRing, so a non-rectangular region (e.g. a
+// country outline) doesn't admit the parts of p that only sit in its bound.
+// That clip is exact for a convex outer ring only; see clipGeometryToRing.
+// bufferedBound is still used as a cheap overlap prefilter before the real
+// clip runs; bufferMeters widens only that prefilter, and the clip itself
+// follows the unbuffered ring. Holes in a limit polygon are not subtracted
+// back out.
+func (l *Limiter) Clip(p Polygon) []Polygon {
+	if l.root == nil {
+		return nil
+	}
+
+	var clipped []Polygon
+	pBound := p.Bound()
+
+	collectLimiterMatches(l.root, pBound, func(limit Polygon) {
+		lBound := bufferedBound(limit, l.bufferMeters)
+
+		if !pBound.Intersects(lBound) {
+			return
+		}
+
+		for _, limitPart := range limit.Iter() {
+			lg, ok := limitPart.(geometryer)
+			if !ok {
+				continue
+			}
+
+			limitPoly, ok := lg.geometry().(orb.Polygon)
+			if !ok || len(limitPoly) == 0 {
+				continue
+			}
+
+			for _, part := range p.Iter() {
+				g, ok := part.(geometryer)
+				if !ok {
+					continue
+				}
+
+				tile, ok := clipGeometryToRing(g.geometry(), limitPoly[0])
+				if !ok {
+					continue
+				}
+
+				if poly, err := NewPolygonFromOrb(tile); err == nil {
+					clipped = append(clipped, poly)
+				}
+			}
+		}
+	})
+
+	return clipped
+}
+
+// Contains reports whether pt falls within bufferMeters of any limit
+// polygon, per DistanceTo's "0 if pt is inside" contract, so bufferMeters
+// actually expands the match instead of only shaping the index tree.
+func (l *Limiter) Contains(pt Point) bool {
+	if l.root == nil {
+		return false
+	}
+
+	ptBound := pt.Bound()
+	found := false
+
+	collectLimiterMatches(l.root, ptBound, func(limit Polygon) {
+		if limit.DistanceTo(pt) <= l.bufferMeters {
+			found = true
+		}
+	})
+
+	return found
+}
+
+func collectLimiterMatches(node *limiterNode, bound orb.Bound, visit func(Polygon)) {
+	if node == nil || !node.bound.Intersects(bound) {
+		return
+	}
+
+	for _, p := range node.polys {
+		visit(p)
+	}
+
+	collectLimiterMatches(node.left, bound, visit)
+	collectLimiterMatches(node.right, bound, visit)
+}
+
+func bufferedBound(p Polygon, bufferMeters float64) orb.Bound {
+	bound := p.Bound()
+
+	if bufferMeters == 0 {
+		return bound
+	}
+
+	latRad := bound.Center()[1] * (math.Pi / 180)
+	degLat := bufferMeters / 111_320
+	degLon := bufferMeters / (111_320 * math.Cos(latRad))
+
+	return orb.Bound{
+		Min: orb.Point{bound.Min[0] - degLon, bound.Min[1] - degLat},
+		Max: orb.Point{bound.Max[0] + degLon, bound.Max[1] + degLat},
+	}
+}
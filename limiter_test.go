@@ -0,0 +1,116 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func mustPolygon(t *testing.T, wkt string) Polygon {
+	t.Helper()
+
+	p, err := NewPolygonFromWKT(wkt)
+	if err != nil {
+		t.Fatalf("NewPolygonFromWKT(%q): %v", wkt, err)
+	}
+
+	return p
+}
+
+func mustPoint(t *testing.T, lon, lat float64) Point {
+	t.Helper()
+
+	p, err := NewPointFromOrb(orb.Point{lon, lat})
+	if err != nil {
+		t.Fatalf("NewPointFromOrb: %v", err)
+	}
+
+	return p
+}
+
+func TestLimiterContainsHonorsBuffer(t *testing.T) {
+	region := mustPolygon(t, "POLYGON((0 0,0 1,1 1,1 0,0 0))")
+
+	// ~5.5km east of the region's eastern edge at the equator.
+	pt := mustPoint(t, 1.05, 0.5)
+
+	cases := []struct {
+		name         string
+		bufferMeters float64
+		want         bool
+	}{
+		{"unbuffered point outside", 0, false},
+		{"buffer too small", 1000, false},
+		{"buffer covers the gap", 50_000, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			limiter := NewLimiter([]Polygon{region}, tc.bufferMeters)
+
+			if got := limiter.Contains(pt); got != tc.want {
+				t.Errorf("Contains() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLimiterClipFollowsActualShape(t *testing.T) {
+	triangle := mustPolygon(t, "POLYGON((0 0,3 0,0 3,0 0))")
+	region := mustPolygon(t, "POLYGON((1 1,1 3,3 3,3 1,1 1))")
+
+	limiter := NewLimiter([]Polygon{region}, 0)
+
+	clipped := limiter.Clip(triangle)
+
+	var area float64
+	for _, poly := range clipped {
+		area += poly.Area()
+	}
+
+	// The triangle's hypotenuse (x+y<=3) slices the region's bound
+	// (1,1)-(3,3) down to the small corner triangle (1,1),(2,1),(1,2),
+	// area 0.5 — not the full 2x2 bbox-to-bbox overlap (area 4) a
+	// rectangle-only clip would have produced.
+	const want = 0.5
+	if diff := area - want; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("clipped area = %v, want %v", area, want)
+	}
+}
+
+func TestLimiterClipFollowsLimitPolygonShape(t *testing.T) {
+	diamond := mustPolygon(t, "POLYGON((2 0,4 2,2 4,0 2,2 0))")
+	feature := mustPolygon(t, "POLYGON((0 0,0 4,4 4,4 0,0 0))")
+
+	limiter := NewLimiter([]Polygon{diamond}, 0)
+
+	clipped := limiter.Clip(feature)
+
+	var area float64
+	for _, poly := range clipped {
+		area += poly.Area()
+	}
+
+	// The diamond's own area is 8; its bound is the full 4x4 square
+	// (area 16). A clip that only reduced the feature to the limit
+	// polygon's bound, rather than its actual ring, would leak in the
+	// whole bound instead of following the diamond's shape.
+	const wantArea = 8
+	if diff := area - wantArea; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("clipped area = %v, want %v (the diamond's own area, not its 16 bbox)", area, wantArea)
+	}
+}
+
+func TestBufferedBound(t *testing.T) {
+	p := mustPolygon(t, "POLYGON((0 0,0 1,1 1,1 0,0 0))")
+
+	unbuffered := bufferedBound(p, 0)
+	if unbuffered != p.Bound() {
+		t.Errorf("bufferedBound with 0 meters = %v, want %v", unbuffered, p.Bound())
+	}
+
+	buffered := bufferedBound(p, 10_000)
+	if !buffered.Contains(p.Bound().Min) || buffered == p.Bound() {
+		t.Errorf("bufferedBound with 10km did not expand the bound: %v", buffered)
+	}
+}
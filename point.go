@@ -2,9 +2,11 @@ package geometry
 
 import (
 	"encoding/hex"
+
 	"github.com/paulmach/orb"
 	"github.com/paulmach/orb/encoding/wkb"
 	"github.com/paulmach/orb/encoding/wkt"
+	"github.com/paulmach/orb/geo"
 	"github.com/paulmach/orb/geojson"
 )
 
@@ -16,6 +18,15 @@ type Point interface {
 	Bound() orb.Bound
 	ToWKT() string
 	ToGeoJSON() geojson.Point
+	// Contains reports whether other is the same location as this point.
+	Contains(other Point) bool
+	// Intersects reports whether this point falls within poly.
+	Intersects(poly Polygon) bool
+	// DistanceTo returns the distance, in meters, between this point and other.
+	DistanceTo(other Point) float64
+	// Nearest returns whichever of points is closest to this point, along
+	// with that distance in meters. Nearest panics if points is empty.
+	Nearest(points []Point) (Point, float64)
 }
 
 func NewPointFromWKB(wkbPoint string) (Point, error) {
@@ -78,6 +89,36 @@ func (p point) ToWKT() string {
 	return wkt.MarshalString(p.Point)
 }
 
+// Contains reports whether other is the same location as p.
+func (p point) Contains(other Point) bool {
+	return p.Lon() == other.Lon() && p.Lat() == other.Lat()
+}
+
+// Intersects reports whether p falls within poly.
+func (p point) Intersects(poly Polygon) bool {
+	return poly.Contains(p)
+}
+
+// DistanceTo returns the geodesic distance, in meters, between p and other.
+func (p point) DistanceTo(other Point) float64 {
+	return geo.Distance(p.Point, orb.Point{other.Lon(), other.Lat()})
+}
+
+// Nearest returns whichever of points is closest to p, along with that
+// distance in meters. Nearest panics if points is empty.
+func (p point) Nearest(points []Point) (Point, float64) {
+	best := points[0]
+	bestDist := p.DistanceTo(best)
+
+	for _, pt := range points[1:] {
+		if d := p.DistanceTo(pt); d < bestDist {
+			best, bestDist = pt, d
+		}
+	}
+
+	return best, bestDist
+}
+
 func isPoint(geom orb.Geometry) bool {
 	return geom.GeoJSONType() == "Point"
 }
@@ -22,6 +22,25 @@ type Polygon interface {
 	Centroid() Point
 	Area() float64
 	Divide(n float64) []Polygon
+	DivideByCount(n int) []Polygon
+	DivideWithPredicate(stop func(Polygon) bool) []Polygon
+	// Project returns a copy of this Polygon with its coordinates converted
+	// to target.
+	Project(target Projection) Polygon
+	// Iter returns the constituent polygons making up this value: a slice
+	// of one for a plain Polygon, or one entry per polygonal member for a
+	// composite built from a GeometryCollection/MultiPolygon.
+	Iter() []Polygon
+	// Contains reports whether pt lies within this polygon.
+	Contains(pt Point) bool
+	// Intersects reports whether this polygon shares any area with other.
+	Intersects(other Polygon) bool
+	// DistanceTo returns the distance, in meters, from pt to this polygon's
+	// nearest edge, or 0 if pt is inside it.
+	DistanceTo(pt Point) float64
+	// Nearest returns whichever of points is closest to this polygon, along
+	// with that distance in meters. Nearest panics if points is empty.
+	Nearest(points []Point) (Point, float64)
 }
 
 var (
@@ -46,7 +65,7 @@ func NewPolygonFromWKB(wkbPolygon string) (Polygon, error) {
 			return nil, ErrFailedGeometryType
 		}
 
-		p = p.Bound().ToPolygon()
+		return newCompositePolygon(p.(orb.Collection))
 	}
 
 	orbCentroid, area := planar.CentroidArea(p)
@@ -59,7 +78,9 @@ func NewPolygonFromWKB(wkbPolygon string) (Polygon, error) {
 	return polygon{
 		centroid: centroid,
 		area:     area,
+		srid:     EPSG4326,
 		Geometry: p,
+		bound:    p.Bound(),
 	}, nil
 }
 
@@ -84,7 +105,9 @@ func NewPolygonFromWKT(wktPolygon string) (Polygon, error) {
 	return polygon{
 		centroid: centroid,
 		area:     area,
+		srid:     EPSG4326,
 		Geometry: p,
+		bound:    p.Bound(),
 	}, nil
 }
 
@@ -103,14 +126,12 @@ func NewPolygonFromOrb(orbPolygon orb.Geometry) (Polygon, error) {
 	return polygon{
 		centroid: centroid,
 		area:     area,
+		srid:     EPSG4326,
 		Geometry: orbPolygon,
+		bound:    orbPolygon.Bound(),
 	}, nil
 }
 
-func NewPolygonFromGeoJSON() {
-
-}
-
 func NewPolygonFromPlanarPoints(points []point) (Polygon, error) {
 	ring := orb.Ring{}
 
@@ -130,9 +151,17 @@ func NewPolygonFromPlanarPoints(points []point) (Polygon, error) {
 type polygon struct {
 	centroid Point
 	area     float64
+	srid     Projection
+	bound    orb.Bound
 	orb.Geometry
 }
 
+// Bound returns the polygon's bounding box, computed once at construction
+// time so repeated bbox pre-checks (e.g. in Contains) stay cheap.
+func (p polygon) Bound() orb.Bound {
+	return p.bound
+}
+
 func (p polygon) ToGeoJSON() geojson.Geometry {
 	return geojson.Geometry{
 		Type:        p.GeoJSONType(),
@@ -152,53 +181,168 @@ func (p polygon) Area() float64 {
 	return p.area
 }
 
-// Divide polygon bound into parts less than n square meters
+// Iter returns p itself for a plain orb.Polygon, or one Polygon per member
+// if p wraps a MultiPolygon.
+func (p polygon) Iter() []Polygon {
+	mp, ok := p.Geometry.(orb.MultiPolygon)
+	if !ok {
+		return []Polygon{p}
+	}
+
+	var parts []Polygon
+	for _, member := range mp {
+		part, err := NewPolygonFromOrb(member)
+		if err != nil {
+			continue
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts
+}
+
+// Divide recursively intersects the polygon's bound against itself, halving
+// the bound each round, and returns every resulting tile whose clipped area
+// is at most n square kilometers, clipped to the polygon's actual shape so tiles
+// outside the polygon (e.g. ocean next to a coastline) are never emitted.
 func (p polygon) Divide(n float64) []Polygon {
+	return p.DivideWithPredicate(func(tile Polygon) bool {
+		return geo.Area(tileGeometry(tile))/1_000_000 <= n
+	})
+}
+
+// tileGeometry returns tile's underlying orb.Geometry so Divide's stop
+// predicate can measure its real clipped area rather than its bound, e.g. a
+// thin sliver tile keeps a large bound across many halvings even once its
+// actual area is tiny. Falls back to tile's bound if it doesn't expose one,
+// which shouldn't happen for a tile that divideGeometry produced.
+func tileGeometry(tile Polygon) orb.Geometry {
+	if g, ok := tile.(geometryer); ok {
+		return g.geometry()
+	}
+
+	return tile.Bound()
+}
+
+// DivideByCount recursively bisects the polygon's bound until it has
+// produced roughly n similarly sized tiles, clipped to the polygon's shape.
+func (p polygon) DivideByCount(n int) []Polygon {
+	return divideGeometryByDepth(p.Geometry, p.Bound(), bisectDepth(n))
+}
+
+// DivideWithPredicate recursively bisects the polygon's bound, clipping each
+// candidate tile to the polygon's shape and stopping a branch as soon as
+// stop reports true for that tile.
+func (p polygon) DivideWithPredicate(stop func(Polygon) bool) []Polygon {
+	return divideGeometry(p.Geometry, p.Bound(), stop)
+}
+
+// divideGeometry recursively splits bbox in half, clipping geom against each
+// half and emitting the clipped tile once shouldStop reports true for it.
+// Halves that don't intersect geom, or that clip away to nothing, are
+// dropped without recursing further.
+func divideGeometry(geom orb.Geometry, bbox orb.Bound, shouldStop func(Polygon) bool) []Polygon {
+	poly, ok := clipGeometryTile(geom, bbox)
+
+	if !ok {
+		return nil
+	}
+
+	if shouldStop(poly) {
+		return []Polygon{poly}
+	}
+
 	var polygons []Polygon
-	bbox := p.Bound()
-	bboxArea := geo.Area(bbox) / 1_000_000
-	if bboxArea <= n {
-		poly, _ := NewPolygonFromOrb(bbox)
+	for _, half := range splitBound(bbox) {
+		polygons = append(polygons, divideGeometry(geom, half, shouldStop)...)
+	}
+
+	return polygons
+}
 
-		return append(polygons, poly)
+// divideGeometryByDepth recursively bisects bbox depth times, clipping geom
+// against the final, depth-th generation of halves.
+func divideGeometryByDepth(geom orb.Geometry, bbox orb.Bound, depth int) []Polygon {
+	poly, ok := clipGeometryTile(geom, bbox)
+
+	if !ok {
+		return nil
+	}
+
+	if depth <= 0 {
+		return []Polygon{poly}
 	}
 
-	for _, half := range divide(bbox) {
-		polygons = append(polygons, half.Divide(n)...)
+	var polygons []Polygon
+	for _, half := range splitBound(bbox) {
+		polygons = append(polygons, divideGeometryByDepth(geom, half, depth-1)...)
 	}
 
 	return polygons
 }
 
-func divide(bbox orb.Bound) [2]Polygon {
-	var parts [2]Polygon
+// clipGeometryTile clips geom to bbox and wraps the result as a Polygon,
+// reporting false if bbox doesn't intersect geom at all or clips it away.
+func clipGeometryTile(geom orb.Geometry, bbox orb.Bound) (Polygon, bool) {
+	if !bbox.Intersects(geom.Bound()) {
+		return nil, false
+	}
+
+	clipped, ok := clipGeometryToBound(geom, bbox)
+
+	if !ok {
+		return nil, false
+	}
+
+	poly, err := NewPolygonFromOrb(clipped)
+
+	if err != nil {
+		return nil, false
+	}
+
+	return poly, true
+}
+
+// bisectDepth returns the number of halvings that produce the closest power
+// of two to n pieces (e.g. 5 -> 2, since 2^2 = 4 is closer to 5 than 2^3 = 8
+// is; 9 -> 3, since 2^3 = 8 is closer to 9 than 2^4 = 16 is).
+func bisectDepth(n int) int {
+	if n <= 1 {
+		return 0
+	}
+
+	depth, count := 0, 1
+
+	for count < n {
+		count *= 2
+		depth++
+	}
+
+	if depth > 0 && n-count/2 < count-n {
+		depth--
+	}
+
+	return depth
+}
+
+func splitBound(bbox orb.Bound) [2]orb.Bound {
+	var parts [2]orb.Bound
 
 	width := geo.Distance(bbox.Min, orb.Point{bbox.Max[0], bbox.Min[1]})
 	height := geo.Distance(bbox.Min, orb.Point{bbox.Min[0], bbox.Max[1]})
 
 	if width > height {
 		centerX := (bbox.Min[0] + bbox.Max[0]) / 2
-		parts[0], _ = NewPolygonFromOrb(orb.Bound{
-			Min: bbox.Min,
-			Max: orb.Point{centerX, bbox.Max[1]},
-		})
-		parts[1], _ = NewPolygonFromOrb(orb.Bound{
-			Min: orb.Point{centerX, bbox.Min[1]},
-			Max: bbox.Max,
-		})
+		parts[0] = orb.Bound{Min: bbox.Min, Max: orb.Point{centerX, bbox.Max[1]}}
+		parts[1] = orb.Bound{Min: orb.Point{centerX, bbox.Min[1]}, Max: bbox.Max}
 
 		return parts
 	}
 
 	centerY := (bbox.Min[1] + bbox.Max[1]) / 2
-	parts[0], _ = NewPolygonFromOrb(orb.Bound{
-		Min: bbox.Min,
-		Max: orb.Point{bbox.Max[0], centerY},
-	})
-	parts[1], _ = NewPolygonFromOrb(orb.Bound{
-		Min: orb.Point{bbox.Min[0], centerY},
-		Max: bbox.Max,
-	})
+	parts[0] = orb.Bound{Min: bbox.Min, Max: orb.Point{bbox.Max[0], centerY}}
+	parts[1] = orb.Bound{Min: orb.Point{bbox.Min[0], centerY}, Max: bbox.Max}
 
 	return parts
 }
@@ -0,0 +1,245 @@
+package geometry
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// ringer is implemented by the concrete Polygon types in this package so
+// Intersects and DistanceTo can walk their edges without caring whether the
+// underlying geometry is a Polygon, MultiPolygon, or GeometryCollection.
+type ringer interface {
+	rings() []orb.Ring
+}
+
+func (p polygon) rings() []orb.Ring {
+	switch g := p.Geometry.(type) {
+	case orb.Polygon:
+		return []orb.Ring(g)
+	case orb.MultiPolygon:
+		var rings []orb.Ring
+		for _, part := range g {
+			rings = append(rings, part...)
+		}
+
+		return rings
+	default:
+		return nil
+	}
+}
+
+func (p compositePolygon) rings() []orb.Ring {
+	var rings []orb.Ring
+
+	for _, part := range p.parts {
+		if r, ok := part.(ringer); ok {
+			rings = append(rings, r.rings()...)
+		}
+	}
+
+	return rings
+}
+
+func ringsOf(p Polygon) []orb.Ring {
+	if r, ok := p.(ringer); ok {
+		return r.rings()
+	}
+
+	return nil
+}
+
+// Contains reports whether pt lies within p: inside its outer ring and
+// outside every hole, via the standard ray-casting algorithm. p's cached
+// bound is checked first so bulk point-in-polygon queries over large ring
+// counts stay fast.
+func (p polygon) Contains(pt Point) bool {
+	target := orb.Point{pt.Lon(), pt.Lat()}
+
+	if !p.bound.Contains(target) {
+		return false
+	}
+
+	return geometryContainsPoint(p.Geometry, target)
+}
+
+func geometryContainsPoint(geom orb.Geometry, pt orb.Point) bool {
+	switch g := geom.(type) {
+	case orb.Polygon:
+		return polygonContainsPoint(g, pt)
+	case orb.MultiPolygon:
+		for _, poly := range g {
+			if polygonContainsPoint(poly, pt) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+func polygonContainsPoint(poly orb.Polygon, pt orb.Point) bool {
+	if len(poly) == 0 || !ringContainsPoint(poly[0], pt) {
+		return false
+	}
+
+	for _, hole := range poly[1:] {
+		if ringContainsPoint(hole, pt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ringContainsPoint is the standard ray-casting point-in-polygon test: count
+// how many times a ray cast from pt crosses the ring's edges.
+func ringContainsPoint(ring orb.Ring, pt orb.Point) bool {
+	inside := false
+	n := len(ring)
+
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		pi, pj := ring[i], ring[j]
+
+		if (pi[1] > pt[1]) != (pj[1] > pt[1]) {
+			x := (pj[0]-pi[0])*(pt[1]-pi[1])/(pj[1]-pi[1]) + pi[0]
+			if pt[0] < x {
+				inside = !inside
+			}
+		}
+	}
+
+	return inside
+}
+
+// Intersects reports whether p shares any area with other: first a cheap
+// bound overlap check, then an edge-crossing test between every ring pair,
+// falling back to a centroid containment check to catch the case where one
+// polygon sits entirely inside the other without any edges crossing.
+func (p polygon) Intersects(other Polygon) bool {
+	return polygonsIntersect(p, other)
+}
+
+func polygonsIntersect(a, b Polygon) bool {
+	if !a.Bound().Intersects(b.Bound()) {
+		return false
+	}
+
+	aRings, bRings := ringsOf(a), ringsOf(b)
+
+	for _, ra := range aRings {
+		for _, rb := range bRings {
+			if ringsCross(ra, rb) {
+				return true
+			}
+		}
+	}
+
+	return a.Contains(b.Centroid()) || b.Contains(a.Centroid())
+}
+
+func ringsCross(a, b orb.Ring) bool {
+	for i := 0; i < len(a)-1; i++ {
+		for j := 0; j < len(b)-1; j++ {
+			if segmentsIntersect(a[i], a[i+1], b[j], b[j+1]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// segmentsIntersect reports whether segments p1p2 and p3p4 cross, using the
+// standard orientation-based segment intersection test.
+func segmentsIntersect(p1, p2, p3, p4 orb.Point) bool {
+	o1 := orientation(p1, p2, p3)
+	o2 := orientation(p1, p2, p4)
+	o3 := orientation(p3, p4, p1)
+	o4 := orientation(p3, p4, p2)
+
+	return o1 != o2 && o3 != o4
+}
+
+// orientation returns 1 for a counter-clockwise turn, -1 for a clockwise
+// turn, or 0 for collinear points.
+func orientation(a, b, c orb.Point) int {
+	cross := (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+
+	switch {
+	case cross > 0:
+		return 1
+	case cross < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// DistanceTo returns the distance, in meters, from pt to p's nearest edge,
+// or 0 if pt is inside p.
+func (p polygon) DistanceTo(pt Point) float64 {
+	if p.Contains(pt) {
+		return 0
+	}
+
+	return nearestRingsDistance(p.rings(), pt)
+}
+
+func nearestRingsDistance(rings []orb.Ring, pt Point) float64 {
+	target := orb.Point{pt.Lon(), pt.Lat()}
+	best := math.Inf(1)
+
+	for _, ring := range rings {
+		for i := 0; i < len(ring)-1; i++ {
+			if d := distanceToSegment(target, ring[i], ring[i+1]); d < best {
+				best = d
+			}
+		}
+	}
+
+	return best
+}
+
+// distanceToSegment returns the geodesic distance, in meters, from pt to its
+// nearest point on segment ab. The nearest point is found by projecting pt
+// onto ab planarly, which is an adequate approximation at the segment
+// lengths ring edges are made of.
+func distanceToSegment(pt, a, b orb.Point) float64 {
+	return geo.Distance(pt, nearestPointOnSegment(pt, a, b))
+}
+
+func nearestPointOnSegment(pt, a, b orb.Point) orb.Point {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+
+	if dx == 0 && dy == 0 {
+		return a
+	}
+
+	t := ((pt[0]-a[0])*dx + (pt[1]-a[1])*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+
+	return orb.Point{a[0] + t*dx, a[1] + t*dy}
+}
+
+// Nearest returns whichever of points is closest to p, along with that
+// distance in meters. Nearest panics if points is empty.
+func (p polygon) Nearest(points []Point) (Point, float64) {
+	return nearestPoint(p, points)
+}
+
+func nearestPoint(from Polygon, points []Point) (Point, float64) {
+	best := points[0]
+	bestDist := from.DistanceTo(best)
+
+	for _, pt := range points[1:] {
+		if d := from.DistanceTo(pt); d < bestDist {
+			best, bestDist = pt, d
+		}
+	}
+
+	return best, bestDist
+}
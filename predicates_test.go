@@ -0,0 +1,125 @@
+package geometry
+
+import "testing"
+
+func TestPolygonContains(t *testing.T) {
+	square := mustPolygon(t, "POLYGON((0 0,0 10,10 10,10 0,0 0))")
+
+	cases := []struct {
+		name string
+		pt   string
+		want bool
+	}{
+		{"inside", "POINT(5 5)", true},
+		{"outside", "POINT(20 20)", false},
+		{"on boundary", "POINT(0 5)", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pt, err := NewPointFromWKT(tc.pt)
+			if err != nil {
+				t.Fatalf("NewPointFromWKT(%q): %v", tc.pt, err)
+			}
+
+			if got := square.Contains(pt); got != tc.want {
+				t.Errorf("Contains(%s) = %v, want %v", tc.pt, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPolygonContainsRespectsHoles(t *testing.T) {
+	withHole := mustPolygon(t, "POLYGON((0 0,0 10,10 10,10 0,0 0),(4 4,4 6,6 6,6 4,4 4))")
+
+	inHole, _ := NewPointFromWKT("POINT(5 5)")
+	outsideHole, _ := NewPointFromWKT("POINT(1 1)")
+
+	if withHole.Contains(inHole) {
+		t.Error("Contains(5,5) = true, want false (inside the hole)")
+	}
+
+	if !withHole.Contains(outsideHole) {
+		t.Error("Contains(1,1) = false, want true")
+	}
+}
+
+func TestPolygonIntersects(t *testing.T) {
+	a := mustPolygon(t, "POLYGON((0 0,0 10,10 10,10 0,0 0))")
+
+	cases := []struct {
+		name string
+		b    string
+		want bool
+	}{
+		{"overlapping", "POLYGON((5 5,5 15,15 15,15 5,5 5))", true},
+		{"disjoint", "POLYGON((100 100,100 110,110 110,110 100,100 100))", false},
+		{"fully contained", "POLYGON((2 2,2 3,3 3,3 2,2 2))", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := mustPolygon(t, tc.b)
+
+			if got := a.Intersects(b); got != tc.want {
+				t.Errorf("Intersects(%s) = %v, want %v", tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPolygonDistanceTo(t *testing.T) {
+	square := mustPolygon(t, "POLYGON((0 0,0 1,1 1,1 0,0 0))")
+
+	inside, _ := NewPointFromWKT("POINT(0.5 0.5)")
+	if d := square.DistanceTo(inside); d != 0 {
+		t.Errorf("DistanceTo(inside) = %v, want 0", d)
+	}
+
+	outside, _ := NewPointFromWKT("POINT(2 0.5)")
+	if d := square.DistanceTo(outside); d <= 0 {
+		t.Errorf("DistanceTo(outside) = %v, want > 0", d)
+	}
+}
+
+func TestPolygonNearest(t *testing.T) {
+	square := mustPolygon(t, "POLYGON((0 0,0 1,1 1,1 0,0 0))")
+
+	near, _ := NewPointFromWKT("POINT(2 0.5)")
+	far, _ := NewPointFromWKT("POINT(10 0.5)")
+
+	nearest, _ := square.Nearest([]Point{far, near})
+
+	if nearest.Lon() != near.Lon() || nearest.Lat() != near.Lat() {
+		t.Errorf("Nearest() = (%v,%v), want (%v,%v)", nearest.Lon(), nearest.Lat(), near.Lon(), near.Lat())
+	}
+}
+
+func TestPointContains(t *testing.T) {
+	a, _ := NewPointFromWKT("POINT(1 1)")
+	b, _ := NewPointFromWKT("POINT(1 1)")
+	c, _ := NewPointFromWKT("POINT(2 2)")
+
+	if !a.Contains(b) {
+		t.Error("Contains(same location) = false, want true")
+	}
+
+	if a.Contains(c) {
+		t.Error("Contains(different location) = true, want false")
+	}
+}
+
+func TestPointIntersects(t *testing.T) {
+	square := mustPolygon(t, "POLYGON((0 0,0 1,1 1,1 0,0 0))")
+
+	inside, _ := NewPointFromWKT("POINT(0.5 0.5)")
+	outside, _ := NewPointFromWKT("POINT(5 5)")
+
+	if !inside.Intersects(square) {
+		t.Error("Intersects(inside) = false, want true")
+	}
+
+	if outside.Intersects(square) {
+		t.Error("Intersects(outside) = true, want false")
+	}
+}
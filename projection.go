@@ -0,0 +1,177 @@
+package geometry
+
+import (
+	"encoding/hex"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkb"
+	"github.com/paulmach/orb/encoding/wkt"
+	"github.com/paulmach/orb/planar"
+)
+
+// Projection identifies the spatial reference system (SRID) a polygon's
+// coordinates are expressed in.
+type Projection int
+
+const (
+	// EPSG4326 is WGS84 longitude/latitude, the default this package has
+	// always assumed input to be in.
+	EPSG4326 Projection = 4326
+	// EPSG3857 is Web Mercator, the projection used by most tile-based
+	// mapping pipelines (Google/Bing/OSM tiles).
+	EPSG3857 Projection = 3857
+)
+
+// earthRadius is the sphere radius (meters) the Web Mercator projection is
+// defined against.
+const earthRadius = 6378137.0
+
+// NewPolygonFromWKBWithSRID parses wkbPolygon like NewPolygonFromWKB, but
+// interprets its coordinates as being in srid. EPSG3857 input is projected
+// to WGS84 before centroid/area are computed, since those assume lon/lat.
+func NewPolygonFromWKBWithSRID(wkbPolygon string, srid Projection) (Polygon, error) {
+	bytes, err := hex.DecodeString(wkbPolygon)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := wkb.Unmarshal(bytes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newPolygonWithSRID(p, srid)
+}
+
+// NewPolygonFromWKTWithSRID parses wktPolygon like NewPolygonFromWKT, but
+// interprets its coordinates as being in srid. EPSG3857 input is projected
+// to WGS84 before centroid/area are computed, since those assume lon/lat.
+func NewPolygonFromWKTWithSRID(wktPolygon string, srid Projection) (Polygon, error) {
+	p, err := wkt.Unmarshal(wktPolygon)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newPolygonWithSRID(p, srid)
+}
+
+func newPolygonWithSRID(p orb.Geometry, srid Projection) (Polygon, error) {
+	if srid == EPSG3857 {
+		p = projectGeometry(p, EPSG3857, EPSG4326)
+	}
+
+	if !isPolygon(p) {
+		if p.GeoJSONType() != "GeometryCollection" {
+			return nil, ErrFailedGeometryType
+		}
+
+		return newCompositePolygon(p.(orb.Collection))
+	}
+
+	orbCentroid, area := planar.CentroidArea(p)
+	centroid, err := NewPointFromOrb(orbCentroid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return polygon{
+		centroid: centroid,
+		area:     area,
+		srid:     EPSG4326,
+		Geometry: p,
+		bound:    p.Bound(),
+	}, nil
+}
+
+// Project returns a copy of p whose coordinates have been converted from its
+// current projection to target. Area and Centroid are left untouched, since
+// they describe the same real-world geometry regardless of which SRID it's
+// currently expressed in.
+func (p polygon) Project(target Projection) Polygon {
+	if target == p.srid {
+		return p
+	}
+
+	projected := projectGeometry(p.Geometry, p.srid, target)
+
+	return polygon{
+		centroid: p.centroid,
+		area:     p.area,
+		srid:     target,
+		Geometry: projected,
+		bound:    projected.Bound(),
+	}
+}
+
+// projectGeometry converts every coordinate of geom from one projection to
+// another. It is a no-op if from == to.
+func projectGeometry(geom orb.Geometry, from, to Projection) orb.Geometry {
+	if from == to {
+		return geom
+	}
+
+	convert := wgs84ToWebMercator
+	if to == EPSG4326 {
+		convert = webMercatorToWGS84
+	}
+
+	switch g := geom.(type) {
+	case orb.Polygon:
+		return projectPolygon(g, convert)
+	case orb.MultiPolygon:
+		projected := make(orb.MultiPolygon, len(g))
+		for i, poly := range g {
+			projected[i] = projectPolygon(poly, convert)
+		}
+
+		return projected
+	case orb.Collection:
+		projected := make(orb.Collection, len(g))
+		for i, member := range g {
+			projected[i] = projectGeometry(member, from, to)
+		}
+
+		return projected
+	default:
+		return geom
+	}
+}
+
+func projectPolygon(poly orb.Polygon, convert func(orb.Point) orb.Point) orb.Polygon {
+	projected := make(orb.Polygon, len(poly))
+
+	for i, ring := range poly {
+		projectedRing := make(orb.Ring, len(ring))
+		for j, pt := range ring {
+			projectedRing[j] = convert(pt)
+		}
+
+		projected[i] = projectedRing
+	}
+
+	return projected
+}
+
+// wgs84ToWebMercator projects a WGS84 lon/lat point to EPSG:3857 meters.
+func wgs84ToWebMercator(pt orb.Point) orb.Point {
+	lonRad := pt[0] * math.Pi / 180
+	latRad := pt[1] * math.Pi / 180
+
+	x := earthRadius * lonRad
+	y := earthRadius * math.Log(math.Tan(math.Pi/4+latRad/2))
+
+	return orb.Point{x, y}
+}
+
+// webMercatorToWGS84 converts an EPSG:3857 point back to WGS84 lon/lat.
+func webMercatorToWGS84(pt orb.Point) orb.Point {
+	lon := pt[0] / earthRadius * 180 / math.Pi
+	lat := (2*math.Atan(math.Exp(pt[1]/earthRadius)) - math.Pi/2) * 180 / math.Pi
+
+	return orb.Point{lon, lat}
+}
@@ -0,0 +1,96 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestNewPolygonFromWKTWithSRIDCachesBound(t *testing.T) {
+	p, err := NewPolygonFromWKTWithSRID("POLYGON((10 10,20 10,20 20,10 20,10 10))", EPSG4326)
+	if err != nil {
+		t.Fatalf("NewPolygonFromWKTWithSRID: %v", err)
+	}
+
+	pt, err := NewPointFromWKT("POINT(15 15)")
+	if err != nil {
+		t.Fatalf("NewPointFromWKT: %v", err)
+	}
+
+	if !p.Contains(pt) {
+		t.Error("Contains(15,15) = false, want true")
+	}
+}
+
+func TestPolygonProjectRoundTrip(t *testing.T) {
+	p, err := NewPolygonFromWKT("POLYGON((10 10,20 10,20 20,10 20,10 10))")
+	if err != nil {
+		t.Fatalf("NewPolygonFromWKT: %v", err)
+	}
+
+	back := p.Project(EPSG3857).Project(EPSG4326)
+
+	origBound, backBound := p.Bound(), back.Bound()
+
+	const eps = 1e-6
+	if math.Abs(origBound.Min[0]-backBound.Min[0]) > eps || math.Abs(origBound.Max[1]-backBound.Max[1]) > eps {
+		t.Errorf("Bound() after roundtrip = %v, want %v", backBound, origBound)
+	}
+
+	pt, err := NewPointFromWKT("POINT(15 15)")
+	if err != nil {
+		t.Fatalf("NewPointFromWKT: %v", err)
+	}
+
+	if !back.Contains(pt) {
+		t.Error("Contains(15,15) after roundtrip = false, want true")
+	}
+}
+
+func TestCompositePolygonProjectRoundTrip(t *testing.T) {
+	collection := orb.Collection{
+		square(0, 0, 1, 1),
+		square(10, 10, 12, 12),
+	}
+
+	p, err := newCompositePolygon(collection)
+	if err != nil {
+		t.Fatalf("newCompositePolygon: %v", err)
+	}
+
+	back := p.Project(EPSG3857).Project(EPSG4326)
+
+	origBound, backBound := p.Bound(), back.Bound()
+
+	const eps = 1e-6
+	if math.Abs(origBound.Min[0]-backBound.Min[0]) > eps || math.Abs(origBound.Max[1]-backBound.Max[1]) > eps {
+		t.Errorf("Bound() after roundtrip = %v, want %v", backBound, origBound)
+	}
+
+	rawOrig := p.(compositePolygon).raw
+	rawBack := back.(compositePolygon).raw
+
+	for i := range rawOrig {
+		origRing := rawOrig[i].(orb.Polygon)[0]
+		backRing := rawBack[i].(orb.Polygon)[0]
+
+		for j := range origRing {
+			if math.Abs(origRing[j][0]-backRing[j][0]) > eps || math.Abs(origRing[j][1]-backRing[j][1]) > eps {
+				t.Errorf("raw member %d point %d = %v, want %v", i, j, backRing[j], origRing[j])
+			}
+		}
+	}
+}
+
+func TestPolygonProjectToOwnSRIDNoOp(t *testing.T) {
+	p, err := NewPolygonFromWKT("POLYGON((0 0,0 1,1 1,1 0,0 0))")
+	if err != nil {
+		t.Fatalf("NewPolygonFromWKT: %v", err)
+	}
+
+	same := p.Project(EPSG4326)
+	if same.ToWKT() != p.ToWKT() {
+		t.Errorf("Project(p.srid) changed coordinates: %s vs %s", same.ToWKT(), p.ToWKT())
+	}
+}